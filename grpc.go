@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/c-ancell/wxdash/proto"
+)
+
+// lookupServer implements pb.LookupServiceServer on top of the same getObservationForZip /
+// getObservationForCity backend the HTTP handlers use.
+type lookupServer struct {
+	pb.UnimplementedLookupServiceServer
+}
+
+func (s *lookupServer) GetObservationByZip(ctx context.Context, req *pb.ZipRequest) (*pb.ObservationResponse, error) {
+	lookup := getObservationForZip(req.GetZipCode(), LookupOptions{Units: req.GetUnits()})
+	return lookupDataToProto(lookup, pb.LocationType_LOCATION_TYPE_ZIP), nil
+}
+
+func (s *lookupServer) GetObservationByCity(ctx context.Context, req *pb.CityRequest) (*pb.ObservationResponse, error) {
+	lookup := getObservationForCity(req.GetCity(), req.GetState(), LookupOptions{Units: req.GetUnits()})
+	return lookupDataToProto(lookup, pb.LocationType_LOCATION_TYPE_CITY), nil
+}
+
+func (s *lookupServer) FiveDay(ctx context.Context, req *pb.ForecastRequest) (*pb.ForecastResponse, error) {
+	var forecast ForecastData
+	switch {
+	case req.GetHourly() && isZipCode(req.GetZipCode()):
+		forecast = getHourlyForecastForZip(req.GetZipCode())
+	case req.GetHourly():
+		forecast = getHourlyForecastForCity(req.GetCity(), req.GetState())
+	case isZipCode(req.GetZipCode()):
+		forecast = getForecastForZip(req.GetZipCode(), int(req.GetDays()))
+	default:
+		forecast = getForecastForCity(req.GetCity(), req.GetState(), int(req.GetDays()))
+	}
+	return forecastDataToProto(forecast), nil
+}
+
+// lookupDataToProto converts our internal LookupData into the RPC's ObservationResponse.
+func lookupDataToProto(lookup LookupData, locType pb.LocationType) *pb.ObservationResponse {
+	return &pb.ObservationResponse{
+		City:         lookup.City,
+		State:        lookup.State,
+		ZipCode:      lookup.ZipCode,
+		Temperature:  lookup.Temperature,
+		Unit:         lookup.Unit,
+		Station:      lookup.Station,
+		LocationType: locType,
+	}
+}
+
+// forecastDataToProto converts our internal ForecastData into the RPC's ForecastResponse.
+func forecastDataToProto(forecast ForecastData) *pb.ForecastResponse {
+	periods := make([]*pb.ForecastPeriod, len(forecast.Periods))
+	for i, p := range forecast.Periods {
+		periods[i] = &pb.ForecastPeriod{
+			Name:             p.Name,
+			Temperature:      p.Temperature,
+			TemperatureUnit:  p.TemperatureUnit,
+			WindSpeed:        p.WindSpeed,
+			ShortForecast:    p.ShortForecast,
+			DetailedForecast: p.DetailedForecast,
+			Icon:             p.Icon,
+		}
+	}
+
+	return &pb.ForecastResponse{
+		City:    forecast.City,
+		State:   forecast.State,
+		ZipCode: forecast.ZipCode,
+		Periods: periods,
+	}
+}
+
+// serveGRPC starts the LookupService gRPC server on addr. It's run alongside the HTTP
+// server in main, on its own port, and blocks until the listener fails.
+func serveGRPC(addr string) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("failed to listen for gRPC on %v: %v", addr, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterLookupServiceServer(grpcServer, &lookupServer{})
+
+	fmt.Printf("gRPC server listening on %v\n", addr)
+	log.Fatal(grpcServer.Serve(lis))
+}
@@ -6,16 +6,56 @@ import (
 	"html/template"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/c-ancell/wxdash/wxclient"
+)
+
+// wxc is the shared, cache- and rate-limit-aware client used for every call to an upstream
+// weather API. api.weather.gov requires a descriptive User-Agent identifying the client.
+var wxc = wxclient.New("wxdash (https://github.com/c-ancell/wxdash)")
+
+// Cache lifetimes for each upstream call: a ZIP/city's lat/lon practically never changes, the
+// nearest station for a given lat/lon rarely changes, and an observation is only refreshed by
+// NOAA every so often.
+const (
+	locationCacheTTL    = 30 * 24 * time.Hour
+	stationCacheTTL     = 7 * 24 * time.Hour
+	observationCacheTTL = 5 * time.Minute
+	forecastCacheTTL    = time.Hour
 )
 
+// defaultMaxObservationAge is how old a station's latest observation is allowed to be before
+// getLatestObservation/averageObservation skip it in favor of the next nearest station.
+const defaultMaxObservationAge = 2 * time.Hour
+
+// averageStationCount is how many of the nearest stations mode=average draws from.
+const averageStationCount = 3
+
+// LookupOptions bundles the knobs that affect how an observation lookup is resolved: which
+// units to report in, whether to use the single nearest valid station or average across the
+// nearest few, and how stale a station's observation can be before it's skipped.
+type LookupOptions struct {
+	Units  string
+	Mode   string
+	MaxAge time.Duration
+}
+
 // LookupData is a struct type that represents the info sent to the frontend when a lookup is performed
 type LookupData struct {
 	ZipCode     string
 	City        string
 	State       string
 	Temperature float64
+	Unit        string
 	Station     string
+	// Stations lists every station that contributed to Temperature: a single entry in the
+	// default mode, or all of them when mode=average was requested.
+	Stations []string
 }
 
 // LocationData is a struct type that represents a location in the USA
@@ -31,6 +71,82 @@ type LocationData struct {
 type WeatherObservation struct {
 	Station     string
 	Temperature float64
+	Timestamp   time.Time
+}
+
+// LocationFields mirrors the "fields" object OpenDataSoft returns for a zip-code/city record.
+type LocationFields struct {
+	City      string  `json:"city"`
+	State     string  `json:"state"`
+	Zip       string  `json:"zip"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// OpenDataSoftResponse is the shape of a response from OpenDataSoft's
+// us-zip-code-latitude-and-longitude dataset search endpoint.
+type OpenDataSoftResponse struct {
+	Records []struct {
+		Fields LocationFields `json:"fields"`
+	} `json:"records"`
+}
+
+// StationsResponse is the shape of a response from the weather.gov
+// /points/{lat},{lon}/stations endpoint.
+type StationsResponse struct {
+	ObservationStations []string `json:"observationStations"`
+}
+
+// ObservationResponse is the shape of a response from the weather.gov
+// /stations/{id}/observations/latest endpoint.
+type ObservationResponse struct {
+	Properties struct {
+		Timestamp   string `json:"timestamp"`
+		Temperature struct {
+			// Value is a pointer because NOAA reports null here when a station hasn't
+			// posted a reading recently - a plain float64 would silently read as 0.
+			Value    *float64 `json:"value"`
+			UnitCode string   `json:"unitCode"`
+		} `json:"temperature"`
+	} `json:"properties"`
+}
+
+// PointsResponse is the shape of a response from the weather.gov /points/{lat},{lon}
+// endpoint. We only care about the forecast URLs it hands back.
+type PointsResponse struct {
+	Properties struct {
+		Forecast       string `json:"forecast"`
+		ForecastHourly string `json:"forecastHourly"`
+	} `json:"properties"`
+}
+
+// GridpointForecastResponse is the shape of a response from a weather.gov gridpoint
+// forecast URL (either the daily "forecast" or the "forecastHourly" one).
+type GridpointForecastResponse struct {
+	Properties struct {
+		Periods []ForecastPeriod `json:"periods"`
+	} `json:"properties"`
+}
+
+// ForecastPeriod is one entry from a NOAA gridpoint forecast - one day, one night, or
+// (in hourly mode) one hour.
+type ForecastPeriod struct {
+	Name             string  `json:"name"`
+	Temperature      float64 `json:"temperature"`
+	TemperatureUnit  string  `json:"temperatureUnit"`
+	WindSpeed        string  `json:"windSpeed"`
+	ShortForecast    string  `json:"shortForecast"`
+	DetailedForecast string  `json:"detailedForecast"`
+	Icon             string  `json:"icon"`
+}
+
+// ForecastData is the struct type that represents the info sent to the frontend when a
+// forecast is performed.
+type ForecastData struct {
+	City    string
+	State   string
+	ZipCode string
+	Periods []ForecastPeriod
 }
 
 func homeHandler(w http.ResponseWriter, req *http.Request) {
@@ -57,14 +173,122 @@ func lookupHandler(w http.ResponseWriter, req *http.Request) {
 		panic(err)
 	}
 
-	// Parse the user entered zip code and turn it into an int
+	// The form accepts either a zip code or a city/state pair. Auto-detect which one
+	// was provided: a purely numeric value is treated as a zip code, anything else
+	// routes through the city geocoder instead.
 	zip := req.Form.Get("zipCode")
-	lookup := getObservationForZip(zip)
+	city := req.Form.Get("city")
+	state := req.Form.Get("state")
+	opts := lookupOptionsFromValues(req.Form)
+
+	var lookup LookupData
+	if isZipCode(zip) {
+		lookup = getObservationForZip(zip, opts)
+	} else {
+		lookup = getObservationForCity(city, state, opts)
+	}
 
 	// Notice the data type here - used to send info into the templates. Might need to be re-worked
 	err = templ.Execute(w, lookup)
+}
+
+// lookupOptionsFromValues reads the units, mode ("average" or the default single-station
+// mode), and maxAge (a Go duration string, e.g. "90m") form/query values into a LookupOptions.
+func lookupOptionsFromValues(values url.Values) LookupOptions {
+	opts := LookupOptions{
+		Units: values.Get("units"),
+		Mode:  values.Get("mode"),
+	}
 
-	// TODO: Use zip code to get current temperature
+	if raw := values.Get("maxAge"); raw != "" {
+		if maxAge, err := time.ParseDuration(raw); err == nil {
+			opts.MaxAge = maxAge
+		}
+	}
+
+	return opts
+}
+
+func forecastHandler(w http.ResponseWriter, req *http.Request) {
+	req.ParseForm()
+
+	// Unlike homeHandler/lookupHandler, a missing template here shouldn't take the whole
+	// server down - report it to the client and bail out instead of panicking.
+	templ, err := template.New("forecast").ParseFiles("forecast")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("There was an error generating the template for this page: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	zip := req.Form.Get("zipCode")
+	city := req.Form.Get("city")
+	state := req.Form.Get("state")
+	hourly := req.Form.Get("hourly") == "true"
+
+	days, err := strconv.Atoi(req.Form.Get("days"))
+	if err != nil || days <= 0 {
+		days = 5
+	}
+
+	var forecast ForecastData
+	switch {
+	case hourly && isZipCode(zip):
+		forecast = getHourlyForecastForZip(zip)
+	case hourly:
+		forecast = getHourlyForecastForCity(city, state)
+	case isZipCode(zip):
+		forecast = getForecastForZip(zip, days)
+	default:
+		forecast = getForecastForCity(city, state, days)
+	}
+
+	err = templ.Execute(w, forecast)
+}
+
+// apiLookupHandler is the JSON equivalent of lookupHandler, for CLI and other non-browser
+// clients: GET /api/v1/lookup?zip=97201 or ?city=Portland&state=OR, optionally &units=imperial.
+func apiLookupHandler(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	zip := query.Get("zip")
+	city := query.Get("city")
+	state := query.Get("state")
+	opts := lookupOptionsFromValues(query)
+
+	var lookup LookupData
+	if isZipCode(zip) {
+		lookup = getObservationForZip(zip, opts)
+	} else {
+		lookup = getObservationForCity(city, state, opts)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(lookup); err != nil {
+		http.Error(w, fmt.Sprintf("error encoding lookup as JSON: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// healthzHandler reports wxc's cache hit and miss counts and last-success time for each
+// upstream host (the counts are reported as-is; no hit rate is computed), so operators can tell at a glance whether a given upstream is being hit too hard or has
+// gone quiet.
+func healthzHandler(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(wxc.Stats()); err != nil {
+		http.Error(w, fmt.Sprintf("error encoding health stats as JSON: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// isZipCode reports whether the given form value looks like a zip code (i.e. it's
+// non-empty and entirely numeric) rather than a city name.
+func isZipCode(zip string) bool {
+	if zip == "" {
+		return false
+	}
+	for _, r := range zip {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
 }
 
 // latLonForZip takes a given zip code as a string and uses the OpenDataSoft API to gather location info relative to that zip code.
@@ -72,141 +296,330 @@ func lookupHandler(w http.ResponseWriter, req *http.Request) {
 func latLonForZip(zip string) LocationData {
 
 	// Build the API request URL using the given zip code.
-	url := fmt.Sprintf("https://public.opendatasoft.com/api/records/1.0/search/?dataset=us-zip-code-latitude-and-longitude&q=%v", zip)
-
-	// This is where we will unpack the JSON data response into a locationData struct. First, we build our struct and the container
-	// to hold the response body (jsonData)
-	var loc LocationData
-	jsonData := getJSONData(url)
-
-	// This is where it gets fun. The location data we need is buried inside the JSON from the API. It's nice to have so much info, but
-	// much of it is unneeded for our purposes.
-	// TODO: Find out if there's a better, cleaner way of doing this instead of drilling down with all of these type assertions. Tags?
-	typedJSONData := jsonData.(map[string]interface{})
-
-	for k := range typedJSONData {
-		if k == "records" {
-			typedRecordsInfo := typedJSONData["records"].([]interface{})
-			typedInfoRecord := typedRecordsInfo[0].(map[string]interface{})
-			for k := range typedInfoRecord {
-				if k == "fields" {
-					typedLocData := typedInfoRecord["fields"].(map[string]interface{})
-					for k = range typedLocData {
-						switch k {
-						case "city":
-							loc.City = typedLocData[k].(string)
-						case "zip":
-							loc.Zip = typedLocData[k].(string)
-						case "longitude":
-							loc.Longitude = typedLocData[k].(float64)
-						case "state":
-							loc.State = typedLocData[k].(string)
-						case "latitude":
-							loc.Latitude = typedLocData[k].(float64)
-						}
-					}
-				}
-			}
-		}
+	query := url.Values{"dataset": {"us-zip-code-latitude-and-longitude"}, "q": {zip}}
+	requestURL := "https://public.opendatasoft.com/api/records/1.0/search/?" + query.Encode()
 
+	var resp OpenDataSoftResponse
+	if err := wxc.GetJSON(requestURL, locationCacheTTL, &resp); err != nil {
+		fmt.Fprintf(os.Stdout, "There was an error processing JSON data: %v\n", err)
+		return LocationData{}
 	}
 
-	return loc
-}
+	if len(resp.Records) == 0 {
+		return LocationData{}
+	}
 
-// getJSONData makes a GET request to the specified URL and returns what is essentially the body of the response
-func getJSONData(requestURL string) interface{} {
-	resp, err := http.Get(requestURL)
-	if err != nil {
-		fmt.Fprintf(os.Stdout, "There was an error making the request to the location info API: %v\n", err)
+	return locationDataFromFields(resp.Records[0].Fields)
+}
 
-		return nil
+// locationDataFromFields copies an OpenDataSoft LocationFields record into our own
+// LocationData struct.
+func locationDataFromFields(f LocationFields) LocationData {
+	return LocationData{
+		City:      f.City,
+		State:     f.State,
+		Zip:       f.Zip,
+		Latitude:  f.Latitude,
+		Longitude: f.Longitude,
 	}
-	defer resp.Body.Close()
+}
 
-	var jsonData interface{}
+// latLonForCity takes a city and state (e.g. "Portland", "OR") and uses the OpenDataSoft API to
+// gather location info relative to that city, the same way latLonForZip does for a zip code.
+// It returns a LocationData struct, which packages the relative info in an easy to use data type.
+func latLonForCity(city, state string) LocationData {
+
+	// Build the API request URL, filtering the dataset on both city and state so we don't
+	// accidentally match a same-named city in another state.
+	query := url.Values{
+		"dataset":      {"us-zip-code-latitude-and-longitude"},
+		"q":            {city},
+		"refine.city":  {city},
+		"refine.state": {state},
+	}
+	requestURL := "https://public.opendatasoft.com/api/records/1.0/search/?" + query.Encode()
 
-	err = json.NewDecoder(resp.Body).Decode(&jsonData)
-	if err != nil {
+	var resp OpenDataSoftResponse
+	if err := wxc.GetJSON(requestURL, locationCacheTTL, &resp); err != nil {
 		fmt.Fprintf(os.Stdout, "There was an error processing JSON data: %v\n", err)
-		return nil
+		return LocationData{}
 	}
 
-	return jsonData
-}
+	if len(resp.Records) == 0 {
+		return LocationData{}
+	}
 
-func findNearestStation(lat, lon float64) string {
+	return locationDataFromFields(resp.Records[0].Fields)
+}
 
-	var stationID string
+// findNearestStation returns the IDs of the stations covering (lat, lon), ordered nearest
+// first, as reported by weather.gov.
+func findNearestStation(lat, lon float64) []string {
 
 	// First, build the request URL
 	url := fmt.Sprintf("https://api.weather.gov/points/%v,%v/stations", lat, lon)
-	jsonData := getJSONData(url)
-	typedJSONData := jsonData.(map[string]interface{})
-	for k := range typedJSONData {
-		if k == "observationStations" {
-			typedStations := typedJSONData["observationStations"].([]interface{})
-			for k := range typedStations {
-				if k == 0 {
-					stationID = typedStations[k].(string)
-					stationID = stationID[len(stationID)-4:]
-					return stationID
-				}
-			}
-		}
+
+	var resp StationsResponse
+	if err := wxc.GetJSON(url, stationCacheTTL, &resp); err != nil {
+		fmt.Fprintf(os.Stdout, "There was an error processing JSON data: %v\n", err)
+		return nil
 	}
 
-	return stationID
+	stationIDs := make([]string, len(resp.ObservationStations))
+	for i, stationURL := range resp.ObservationStations {
+		stationIDs[i] = stationIDFromURL(stationURL)
+	}
+	return stationIDs
 }
 
-func getObservationForZip(zip string) LookupData {
+// stationIDFromURL extracts the station identifier from a weather.gov station URL, e.g.
+// "https://api.weather.gov/stations/KPDX" -> "KPDX". Unlike slicing the last 4 characters,
+// this works regardless of the identifier's length.
+func stationIDFromURL(stationURL string) string {
+	parts := strings.Split(stationURL, "/")
+	return parts[len(parts)-1]
+}
 
+func getObservationForZip(zip string, opts LookupOptions) LookupData {
 	// Get location info for the requested ZIP code.
 	loc := latLonForZip(zip)
+	return getObservationForLocation(loc, opts)
+}
+
+// getObservationForCity mirrors getObservationForZip, but starts from a city/state pair
+// instead of a zip code.
+func getObservationForCity(city, state string, opts LookupOptions) LookupData {
+	loc := latLonForCity(city, state)
+	return getObservationForLocation(loc, opts)
+}
+
+// getObservationForLocation runs the shared findNearestStation -> getLatestObservation
+// pipeline for an already-resolved LocationData, converting the result to the requested
+// units before handing back a LookupData. When opts.Mode is "average", it instead averages
+// valid temperatures across the nearest averageStationCount stations.
+func getObservationForLocation(loc LocationData, opts LookupOptions) LookupData {
+	maxAge := opts.MaxAge
+	if maxAge <= 0 {
+		maxAge = defaultMaxObservationAge
+	}
 
-	// Look up nearest station from weather.gov API for that lat/lon pair
-	nearestStation := findNearestStation(loc.Latitude, loc.Longitude)
+	// Look up nearest stations from weather.gov API for that lat/lon pair, ordered nearest first.
+	nearestStations := findNearestStation(loc.Latitude, loc.Longitude)
+
+	var observation WeatherObservation
+	var contributingStations []string
+	if opts.Mode == "average" {
+		observation, contributingStations = averageObservation(nearestStations, maxAge, averageStationCount)
+	} else {
+		observation = getLatestObservation(nearestStations, maxAge)
+		if observation.Station != "" {
+			contributingStations = []string{observation.Station}
+		}
+	}
+
+	temperature, unitLabel := convertTemperature(observation.Temperature, opts.Units)
 
-	// Get latest observation from station, get temperature
-	latestObservation := getLatestObservation(nearestStation)
 	return LookupData{
 		City:        loc.City,
 		State:       loc.State,
 		ZipCode:     loc.Zip,
-		Temperature: latestObservation.Temperature,
-		Station:     latestObservation.Station,
+		Temperature: temperature,
+		Unit:        unitLabel,
+		Station:     observation.Station,
+		Stations:    contributingStations,
 	}
+}
 
+// convertTemperature converts a Celsius reading from NOAA into the requested units
+// ("imperial" -> Fahrenheit, "standard" -> Kelvin, anything else, including "metric",
+// stays in Celsius) and returns the converted value alongside its display label.
+func convertTemperature(celsius float64, units string) (float64, string) {
+	switch strings.ToLower(units) {
+	case "imperial":
+		return celsius*9/5 + 32, "°F"
+	case "standard":
+		return celsius + 273.15, "K"
+	default:
+		return celsius, "°C"
+	}
 }
 
-func getLatestObservation(station string) WeatherObservation {
+// getForecastForZip fetches the daily forecast for a zip code, truncated to the given
+// number of days (NOAA returns a day/night period per day, so days=5 yields up to 10
+// periods).
+func getForecastForZip(zip string, days int) ForecastData {
+	loc := latLonForZip(zip)
+	return getForecastForLocation(loc, days, false)
+}
 
-	latestObservation := WeatherObservation{station, 0}
+// getForecastForCity mirrors getForecastForZip, but starts from a city/state pair.
+func getForecastForCity(city, state string, days int) ForecastData {
+	loc := latLonForCity(city, state)
+	return getForecastForLocation(loc, days, false)
+}
 
-	requestURL := fmt.Sprintf("https://api.weather.gov/stations/%v/observations/latest", station)
-	jsonData := getJSONData(requestURL)
-	typedJSONData := jsonData.(map[string]interface{})
-	for k, v := range typedJSONData {
-		if k == "properties" {
-			typedProps := v.(map[string]interface{})
-			for k, v := range typedProps {
-				if k == "temperature" {
-					typedTemp := v.(map[string]interface{})
-					for k, v := range typedTemp {
-						if k == "value" {
-							latestObservation.Temperature = v.(float64)
-						}
-					}
-				}
-			}
+// getHourlyForecastForZip fetches the full hourly forecast for a zip code.
+func getHourlyForecastForZip(zip string) ForecastData {
+	loc := latLonForZip(zip)
+	return getForecastForLocation(loc, 0, true)
+}
+
+// getHourlyForecastForCity mirrors getHourlyForecastForZip, but starts from a city/state pair.
+func getHourlyForecastForCity(city, state string) ForecastData {
+	loc := latLonForCity(city, state)
+	return getForecastForLocation(loc, 0, true)
+}
+
+// getForecastForLocation looks up the forecast URL for loc via the weather.gov points
+// endpoint, then fetches that URL's periods. When hourly is true, the forecastHourly URL
+// is used instead of the daily one and days is ignored.
+func getForecastForLocation(loc LocationData, days int, hourly bool) ForecastData {
+	pointsURL := fmt.Sprintf("https://api.weather.gov/points/%v,%v", loc.Latitude, loc.Longitude)
+
+	var points PointsResponse
+	if err := wxc.GetJSON(pointsURL, stationCacheTTL, &points); err != nil {
+		fmt.Fprintf(os.Stdout, "There was an error processing JSON data: %v\n", err)
+		return ForecastData{City: loc.City, State: loc.State, ZipCode: loc.Zip}
+	}
+
+	forecastURL := points.Properties.Forecast
+	if hourly {
+		forecastURL = points.Properties.ForecastHourly
+	}
+
+	var gridpoint GridpointForecastResponse
+	if err := wxc.GetJSON(forecastURL, forecastCacheTTL, &gridpoint); err != nil {
+		fmt.Fprintf(os.Stdout, "There was an error processing JSON data: %v\n", err)
+		return ForecastData{City: loc.City, State: loc.State, ZipCode: loc.Zip}
+	}
+
+	return ForecastData{
+		City:    loc.City,
+		State:   loc.State,
+		ZipCode: loc.Zip,
+		Periods: truncatePeriods(gridpoint.Properties.Periods, days, hourly),
+	}
+}
+
+// truncatePeriods limits periods to the requested number of days. NOAA returns a day/night
+// period per day, so days=5 yields up to 10 periods. Hourly forecasts and days<=0 are
+// returned untruncated.
+func truncatePeriods(periods []ForecastPeriod, days int, hourly bool) []ForecastPeriod {
+	if hourly || days <= 0 {
+		return periods
+	}
+
+	periodLimit := days * 2
+	if periodLimit < len(periods) {
+		return periods[:periodLimit]
+	}
+	return periods
+}
+
+// fetchStationObservation is a seam over getStationObservation so getLatestObservation and
+// averageObservation's fallback/averaging logic can be tested without hitting the network.
+var fetchStationObservation = getStationObservation
+
+// getLatestObservation walks stations in order (nearest first) and returns the first one
+// with a non-null, non-stale temperature reading. If none qualify, it returns a zero
+// WeatherObservation with an empty Station.
+func getLatestObservation(stations []string, maxAge time.Duration) WeatherObservation {
+	for _, station := range stations {
+		observation, err := fetchStationObservation(station)
+		if err != nil {
+			fmt.Fprintf(os.Stdout, "skipping station %v: %v\n", station, err)
+			continue
+		}
+		if time.Since(observation.Timestamp) > maxAge {
+			fmt.Fprintf(os.Stdout, "skipping station %v: observation is older than %v\n", station, maxAge)
+			continue
 		}
+		return observation
 	}
 
-	return latestObservation
+	return WeatherObservation{}
+}
+
+// averageObservation walks stations in order (nearest first), averaging the temperatures of
+// up to n stations with a non-null, non-stale reading. It returns the averaged observation
+// along with the IDs of the stations that contributed to it.
+func averageObservation(stations []string, maxAge time.Duration, n int) (WeatherObservation, []string) {
+	var total float64
+	var contributing []string
+
+	for _, station := range stations {
+		if len(contributing) >= n {
+			break
+		}
+
+		observation, err := fetchStationObservation(station)
+		if err != nil {
+			fmt.Fprintf(os.Stdout, "skipping station %v: %v\n", station, err)
+			continue
+		}
+		if time.Since(observation.Timestamp) > maxAge {
+			fmt.Fprintf(os.Stdout, "skipping station %v: observation is older than %v\n", station, maxAge)
+			continue
+		}
+
+		total += observation.Temperature
+		contributing = append(contributing, station)
+	}
+
+	if len(contributing) == 0 {
+		return WeatherObservation{}, nil
+	}
+
+	return WeatherObservation{Temperature: total / float64(len(contributing))}, contributing
+}
+
+// getStationObservation fetches a single station's latest observation. It returns an error
+// if the request fails, the temperature reading is null, or the timestamp can't be parsed -
+// all of which mean this station can't be used and the caller should move on to the next one.
+func getStationObservation(station string) (WeatherObservation, error) {
+	requestURL := fmt.Sprintf("https://api.weather.gov/stations/%v/observations/latest", station)
+
+	var resp ObservationResponse
+	if err := wxc.GetJSON(requestURL, observationCacheTTL, &resp); err != nil {
+		return WeatherObservation{}, err
+	}
+
+	if resp.Properties.Temperature.Value == nil {
+		return WeatherObservation{}, fmt.Errorf("no temperature reading")
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, resp.Properties.Timestamp)
+	if err != nil {
+		return WeatherObservation{}, fmt.Errorf("unparseable observation timestamp %q: %w", resp.Properties.Timestamp, err)
+	}
+
+	temp := resp.Properties.Temperature
+	return WeatherObservation{
+		Station:     station,
+		Temperature: celsiusFromUnitCode(*temp.Value, temp.UnitCode),
+		Timestamp:   timestamp,
+	}, nil
+}
+
+// celsiusFromUnitCode normalizes a NOAA observation value to Celsius based on its unitCode
+// (e.g. "wmoUnit:degC" or "wmoUnit:degF"), rather than assuming the reading is already Celsius.
+func celsiusFromUnitCode(value float64, unitCode string) float64 {
+	if strings.Contains(strings.ToLower(unitCode), "degf") {
+		return (value - 32) * 5 / 9
+	}
+	return value
 }
 
 func main() {
 	http.HandleFunc("/", homeHandler)
 	http.HandleFunc("/lookup", lookupHandler)
+	http.HandleFunc("/forecast", forecastHandler)
+	http.HandleFunc("/api/v1/lookup", apiLookupHandler)
+	http.HandleFunc("/healthz", healthzHandler)
+
+	// Run the gRPC server alongside the HTTP server, on its own port, so programmatic
+	// clients can use either surface against the same backend.
+	go serveGRPC(":9090")
+
 	log.Fatal(http.ListenAndServe(":8080", nil))
 }
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestIsZipCode(t *testing.T) {
+	cases := []struct {
+		in   string
+		want bool
+	}{
+		{"97201", true},
+		{"", false},
+		{"Portland", false},
+		{"97201-1234", false},
+	}
+
+	for _, c := range cases {
+		if got := isZipCode(c.in); got != c.want {
+			t.Errorf("isZipCode(%q) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestConvertTemperature(t *testing.T) {
+	cases := []struct {
+		celsius  float64
+		units    string
+		wantTemp float64
+		wantUnit string
+	}{
+		{0, "imperial", 32, "°F"},
+		{0, "standard", 273.15, "K"},
+		{0, "metric", 0, "°C"},
+		{0, "", 0, "°C"},
+		{100, "imperial", 212, "°F"},
+	}
+
+	for _, c := range cases {
+		gotTemp, gotUnit := convertTemperature(c.celsius, c.units)
+		if gotTemp != c.wantTemp || gotUnit != c.wantUnit {
+			t.Errorf("convertTemperature(%v, %q) = (%v, %q), want (%v, %q)", c.celsius, c.units, gotTemp, gotUnit, c.wantTemp, c.wantUnit)
+		}
+	}
+}
+
+func TestCelsiusFromUnitCode(t *testing.T) {
+	cases := []struct {
+		value    float64
+		unitCode string
+		want     float64
+	}{
+		{0, "wmoUnit:degC", 0},
+		{32, "wmoUnit:degF", 0},
+		{212, "wmoUnit:degF", 100},
+		{0, "", 0},
+	}
+
+	for _, c := range cases {
+		if got := celsiusFromUnitCode(c.value, c.unitCode); math.Abs(got-c.want) > 1e-9 {
+			t.Errorf("celsiusFromUnitCode(%v, %q) = %v, want %v", c.value, c.unitCode, got, c.want)
+		}
+	}
+}
+
+func TestStationIDFromURL(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{"https://api.weather.gov/stations/KPDX", "KPDX"},
+		{"https://api.weather.gov/stations/KPDX-1", "KPDX-1"},
+		{"KPDX", "KPDX"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := stationIDFromURL(c.in); got != c.want {
+			t.Errorf("stationIDFromURL(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+// withFakeStationObservations stubs fetchStationObservation for the duration of a test with
+// canned results keyed by station ID, restoring the real implementation afterward.
+func withFakeStationObservations(t *testing.T, observations map[string]WeatherObservation, errs map[string]error) {
+	t.Helper()
+	orig := fetchStationObservation
+	fetchStationObservation = func(station string) (WeatherObservation, error) {
+		if err, ok := errs[station]; ok {
+			return WeatherObservation{}, err
+		}
+		return observations[station], nil
+	}
+	t.Cleanup(func() { fetchStationObservation = orig })
+}
+
+func TestGetLatestObservationSkipsFailingAndStaleStations(t *testing.T) {
+	now := time.Now()
+	withFakeStationObservations(t,
+		map[string]WeatherObservation{
+			"KSTALE": {Station: "KSTALE", Temperature: 10, Timestamp: now.Add(-2 * time.Hour)},
+			"KGOOD":  {Station: "KGOOD", Temperature: 20, Timestamp: now},
+		},
+		map[string]error{
+			"KBAD": fmt.Errorf("no temperature reading"),
+		},
+	)
+
+	got := getLatestObservation([]string{"KBAD", "KSTALE", "KGOOD"}, time.Hour)
+
+	if got.Station != "KGOOD" || got.Temperature != 20 {
+		t.Errorf("getLatestObservation = %+v, want station KGOOD with temperature 20", got)
+	}
+}
+
+func TestGetLatestObservationReturnsZeroValueWhenNoneQualify(t *testing.T) {
+	withFakeStationObservations(t, nil, map[string]error{
+		"KBAD": fmt.Errorf("no temperature reading"),
+	})
+
+	got := getLatestObservation([]string{"KBAD"}, time.Hour)
+
+	if got != (WeatherObservation{}) {
+		t.Errorf("getLatestObservation = %+v, want zero value", got)
+	}
+}
+
+func TestAverageObservationAveragesUpToNStations(t *testing.T) {
+	now := time.Now()
+	withFakeStationObservations(t,
+		map[string]WeatherObservation{
+			"KONE":   {Station: "KONE", Temperature: 10, Timestamp: now},
+			"KTWO":   {Station: "KTWO", Temperature: 20, Timestamp: now},
+			"KTHREE": {Station: "KTHREE", Temperature: 30, Timestamp: now},
+		},
+		nil,
+	)
+
+	avg, contributing := averageObservation([]string{"KONE", "KTWO", "KTHREE"}, time.Hour, 2)
+
+	if avg.Temperature != 15 {
+		t.Errorf("averageObservation temperature = %v, want 15", avg.Temperature)
+	}
+	wantContributing := []string{"KONE", "KTWO"}
+	if len(contributing) != len(wantContributing) {
+		t.Fatalf("contributing stations = %v, want %v", contributing, wantContributing)
+	}
+	for i, s := range wantContributing {
+		if contributing[i] != s {
+			t.Errorf("contributing[%d] = %v, want %v", i, contributing[i], s)
+		}
+	}
+}
+
+func TestAverageObservationSkipsStaleAndFailingStations(t *testing.T) {
+	now := time.Now()
+	withFakeStationObservations(t,
+		map[string]WeatherObservation{
+			"KSTALE": {Station: "KSTALE", Temperature: 100, Timestamp: now.Add(-2 * time.Hour)},
+			"KGOOD":  {Station: "KGOOD", Temperature: 20, Timestamp: now},
+		},
+		map[string]error{
+			"KBAD": fmt.Errorf("no temperature reading"),
+		},
+	)
+
+	avg, contributing := averageObservation([]string{"KBAD", "KSTALE", "KGOOD"}, time.Hour, 2)
+
+	if avg.Temperature != 20 {
+		t.Errorf("averageObservation temperature = %v, want 20", avg.Temperature)
+	}
+	if len(contributing) != 1 || contributing[0] != "KGOOD" {
+		t.Errorf("contributing = %v, want [KGOOD]", contributing)
+	}
+}
+
+func TestTruncatePeriods(t *testing.T) {
+	periods := make([]ForecastPeriod, 12)
+	for i := range periods {
+		periods[i].Name = fmt.Sprintf("period-%d", i)
+	}
+
+	cases := []struct {
+		name   string
+		days   int
+		hourly bool
+		want   int
+	}{
+		{"daily truncates to 2 periods per day", 5, false, 10},
+		{"daily days<=0 is untouched", 0, false, 12},
+		{"daily request longer than available periods stays untouched", 20, false, 12},
+		{"hourly ignores days", 5, true, 12},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := truncatePeriods(periods, c.days, c.hourly)
+			if len(got) != c.want {
+				t.Errorf("truncatePeriods(periods, %d, %v) has %d periods, want %d", c.days, c.hourly, len(got), c.want)
+			}
+		})
+	}
+}
+
+func TestAverageObservationReturnsZeroValueWhenNoneQualify(t *testing.T) {
+	withFakeStationObservations(t, nil, map[string]error{
+		"KBAD": fmt.Errorf("no temperature reading"),
+	})
+
+	avg, contributing := averageObservation([]string{"KBAD"}, time.Hour, 2)
+
+	if avg != (WeatherObservation{}) {
+		t.Errorf("averageObservation = %+v, want zero value", avg)
+	}
+	if contributing != nil {
+		t.Errorf("contributing = %v, want nil", contributing)
+	}
+}
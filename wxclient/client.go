@@ -0,0 +1,276 @@
+// Package wxclient wraps the handful of upstream HTTP APIs wxdash depends on (OpenDataSoft,
+// api.weather.gov) with a shared cache and a per-host rate limiter, so a single page load
+// doesn't re-fetch the same ZIP/station/observation data on every request.
+package wxclient
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Stats summarizes how a single upstream host's cache has performed, surfaced via /healthz.
+type Stats struct {
+	Hits        int
+	Misses      int
+	LastSuccess time.Time
+}
+
+// maxCacheEntries bounds how many distinct URLs a Client will cache at once. Without a cap, a
+// long-running process fielding arbitrary zip/city lookups would grow its cache forever.
+const maxCacheEntries = 1000
+
+type cacheEntry struct {
+	requestURL string
+	body       []byte
+	etag       string
+	expiresAt  time.Time
+}
+
+// Client is a cache- and rate-limit-aware wrapper around http.Get, used for every call to an
+// upstream weather API. The zero value is not usable; construct one with New.
+//
+// The cache is bounded to maxCacheEntries and evicts least-recently-used entries: cache holds
+// the entries keyed by URL, and order tracks recency with the front of the list being most
+// recently used.
+type Client struct {
+	httpClient *http.Client
+	userAgent  string
+
+	mu       sync.Mutex
+	cache    map[string]*list.Element
+	order    *list.List
+	stats    map[string]*Stats
+	limiters map[string]*tokenBucket
+}
+
+// New builds a Client that identifies itself to upstream APIs with userAgent, which
+// api.weather.gov requires on every request.
+func New(userAgent string) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		userAgent:  userAgent,
+		cache:      make(map[string]*list.Element),
+		order:      list.New(),
+		stats:      make(map[string]*Stats),
+		limiters:   make(map[string]*tokenBucket),
+	}
+}
+
+// GetJSON fetches requestURL and decodes its JSON body into target, serving from cache when
+// a prior response is still within ttl. Once a cached entry expires it's revalidated with
+// If-None-Match when the upstream supplied an ETag (otherwise it's re-fetched), so a 304
+// from the upstream still counts as a cache hit. A response outside the 2xx range is
+// returned as an error and is neither cached nor recorded as a success.
+func (c *Client) GetJSON(requestURL string, ttl time.Duration, target interface{}) error {
+	entry, cached := c.lookup(requestURL)
+
+	if cached && time.Now().Before(entry.expiresAt) {
+		c.recordHit(requestURL)
+		return json.Unmarshal(entry.body, target)
+	}
+
+	// Only the actual network fetch below is rate-limited - a cache hit above never touches
+	// the upstream host, so it shouldn't burn a token from that host's bucket.
+	c.limiterFor(requestURL).take()
+
+	req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("building request for %v: %w", requestURL, err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	if cached && entry.etag != "" {
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("requesting %v: %w", requestURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && cached {
+		entry.expiresAt = time.Now().Add(ttl)
+		c.touch(requestURL)
+		c.recordHit(requestURL)
+		return json.Unmarshal(entry.body, target)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("requesting %v: unexpected status %v", requestURL, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response from %v: %w", requestURL, err)
+	}
+
+	c.store(requestURL, &cacheEntry{
+		requestURL: requestURL,
+		body:       body,
+		etag:       resp.Header.Get("ETag"),
+		expiresAt:  time.Now().Add(ttl),
+	})
+	c.recordMiss(requestURL)
+
+	return json.Unmarshal(body, target)
+}
+
+// lookup returns the cache entry for requestURL, if any, and marks it most-recently-used.
+func (c *Client) lookup(requestURL string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.cache[requestURL]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*cacheEntry), true
+}
+
+// touch marks requestURL's entry as most-recently-used without changing its contents.
+func (c *Client) touch(requestURL string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.cache[requestURL]; ok {
+		c.order.MoveToFront(elem)
+	}
+}
+
+// store inserts or replaces the cache entry for requestURL, evicting the least-recently-used
+// entry if the cache is at capacity.
+func (c *Client) store(requestURL string, entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.cache[requestURL]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.cache[requestURL] = c.order.PushFront(entry)
+	if c.order.Len() > maxCacheEntries {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.cache, oldest.Value.(*cacheEntry).requestURL)
+	}
+}
+
+// Stats returns a snapshot of the cache hit/miss counts and last-success time for every
+// upstream host this client has talked to, keyed by host.
+func (c *Client) Stats() map[string]Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot := make(map[string]Stats, len(c.stats))
+	for host, s := range c.stats {
+		snapshot[host] = *s
+	}
+	return snapshot
+}
+
+func (c *Client) recordHit(requestURL string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.statsFor(requestURL)
+	s.Hits++
+	s.LastSuccess = time.Now()
+}
+
+func (c *Client) recordMiss(requestURL string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.statsFor(requestURL)
+	s.Misses++
+	s.LastSuccess = time.Now()
+}
+
+// statsFor returns the Stats entry for requestURL's host, creating it if needed. Callers must
+// hold c.mu.
+func (c *Client) statsFor(requestURL string) *Stats {
+	host := hostOf(requestURL)
+	s, ok := c.stats[host]
+	if !ok {
+		s = &Stats{}
+		c.stats[host] = s
+	}
+	return s
+}
+
+// limiterFor returns the token bucket for requestURL's host, creating one if needed.
+func (c *Client) limiterFor(requestURL string) *tokenBucket {
+	host := hostOf(requestURL)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.limiters[host]
+	if !ok {
+		// A handful of requests may burst through immediately, then throttle to roughly
+		// one request per second - comfortably under api.weather.gov's documented limits.
+		b = newTokenBucket(5, 1)
+		c.limiters[host] = b
+	}
+	return b
+}
+
+func hostOf(requestURL string) string {
+	parsed, err := url.Parse(requestURL)
+	if err != nil {
+		return requestURL
+	}
+	return parsed.Host
+}
+
+// tokenBucket is a minimal token-bucket rate limiter: it holds up to max tokens, refills at
+// refillPerSecond, and take blocks until a token is available.
+type tokenBucket struct {
+	mu              sync.Mutex
+	tokens          float64
+	max             float64
+	refillPerSecond float64
+	last            time.Time
+}
+
+func newTokenBucket(max, refillPerSecond float64) *tokenBucket {
+	return &tokenBucket{tokens: max, max: max, refillPerSecond: refillPerSecond, last: time.Now()}
+}
+
+func (b *tokenBucket) take() {
+	for {
+		b.mu.Lock()
+		b.refill()
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return
+		}
+		wait := time.Duration((1 - b.tokens) / b.refillPerSecond * float64(time.Second))
+		b.mu.Unlock()
+		time.Sleep(wait)
+	}
+}
+
+// refill tops up the bucket based on how long it's been since the last refill. Callers must
+// hold b.mu.
+func (b *tokenBucket) refill() {
+	now := time.Now()
+	elapsed := now.Sub(b.last).Seconds()
+	b.tokens = minFloat(b.max, b.tokens+elapsed*b.refillPerSecond)
+	b.last = now
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
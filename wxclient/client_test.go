@@ -0,0 +1,88 @@
+package wxclient
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetJSONCachesWithinTTL(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		fmt.Fprint(w, `{"value":1}`)
+	}))
+	defer server.Close()
+
+	c := New("wxdash-test")
+	var target struct{ Value int }
+
+	if err := c.GetJSON(server.URL, time.Minute, &target); err != nil {
+		t.Fatalf("first GetJSON: %v", err)
+	}
+	if err := c.GetJSON(server.URL, time.Minute, &target); err != nil {
+		t.Fatalf("second GetJSON: %v", err)
+	}
+
+	if hits != 1 {
+		t.Errorf("got %d upstream hits, want 1 (second call should have been served from cache)", hits)
+	}
+	if target.Value != 1 {
+		t.Errorf("target.Value = %v, want 1", target.Value)
+	}
+}
+
+func TestGetJSONRefetchesAfterTTLExpires(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		fmt.Fprint(w, `{"value":1}`)
+	}))
+	defer server.Close()
+
+	c := New("wxdash-test")
+	var target struct{ Value int }
+
+	if err := c.GetJSON(server.URL, time.Millisecond, &target); err != nil {
+		t.Fatalf("first GetJSON: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if err := c.GetJSON(server.URL, time.Millisecond, &target); err != nil {
+		t.Fatalf("second GetJSON: %v", err)
+	}
+
+	if hits != 2 {
+		t.Errorf("got %d upstream hits, want 2 (entry should have expired)", hits)
+	}
+}
+
+// TestCacheEvictsLeastRecentlyUsedOverCapacity exercises store()'s eviction directly rather
+// than through GetJSON: driving maxCacheEntries+1 real requests through a single host would
+// serialize behind that host's rate limiter (5 burst, 1/sec), making the test take ~1000s.
+func TestCacheEvictsLeastRecentlyUsedOverCapacity(t *testing.T) {
+	c := New("wxdash-test")
+
+	urls := make([]string, maxCacheEntries+1)
+	for i := range urls {
+		urls[i] = fmt.Sprintf("https://example.com/?id=%d", i)
+		c.store(urls[i], &cacheEntry{requestURL: urls[i], body: []byte(`{"value":1}`)})
+	}
+
+	c.mu.Lock()
+	size := len(c.cache)
+	_, oldestStillCached := c.cache[urls[0]]
+	_, newestStillCached := c.cache[urls[len(urls)-1]]
+	c.mu.Unlock()
+
+	if size != maxCacheEntries {
+		t.Errorf("cache holds %d entries, want %d (should be capped)", size, maxCacheEntries)
+	}
+	if oldestStillCached {
+		t.Errorf("least-recently-used entry %v is still cached, want evicted", urls[0])
+	}
+	if !newestStillCached {
+		t.Errorf("most-recently-used entry %v was evicted, want cached", urls[len(urls)-1])
+	}
+}